@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+// Checkpointer persists graph execution state so a run can survive process
+// restarts and be resumed later with [Graph.Resume].
+//
+// Save and Load are keyed by an opaque runID; Graph doesn't interpret it,
+// so callers (typically the worker package, which already assigns each run
+// an ID) can use whatever scheme their control plane uses.
+type Checkpointer interface {
+	// Save persists state for runID at step. step increases by one on
+	// every call for a given runID, so implementations can order
+	// checkpoints without inspecting state.
+	Save(ctx context.Context, runID string, step int, state any) error
+
+	// Load returns the most recently saved step and state for runID.
+	Load(ctx context.Context, runID string) (step int, state any, err error)
+}
+
+// checkpointRecord is the value Graph actually hands to Checkpointer.Save:
+// the state at that point in execution plus which node should run next,
+// so Resume knows where to re-enter the graph.
+type checkpointRecord struct {
+	NextNode string
+	State    any
+
+	// Waiting is set when this checkpoint was saved because a node
+	// returned an [Interrupt] error rather than after a normal transition.
+	Waiting bool
+	Reason  string
+	Payload any
+}
+
+type runIDKey struct{}
+
+// WithRunID attaches a run ID to ctx so [Graph.Run] and [Graph.Stream] know
+// which run to checkpoint under. Without a run ID in ctx, execution
+// proceeds without checkpointing even if a [Checkpointer] is configured.
+func WithRunID(ctx context.Context, runID string) context.Context {
+	return context.WithValue(ctx, runIDKey{}, runID)
+}
+
+// RunIDFromContext returns the run ID attached with [WithRunID], if any.
+func RunIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(runIDKey{}).(string)
+	return id, ok
+}
+
+// Resume reloads the checkpoint for runID and continues execution at the
+// node that was about to run (or that returned [Interrupt]) when it was
+// saved, injecting resumeInput into that node's context (see
+// [ResumeFromContext]).
+//
+// Resume requires a [Checkpointer] to have been set with
+// [Graph.SetCheckpointer].
+//
+// Like [Graph.Run], Resume returns an error matching [ErrInterrupt] (rather
+// than nil) if the resumed node interrupts again.
+//
+// Example:
+//
+//	result, err := g.Resume(ctx, runID, approvalDecision)
+func (g *Graph[S]) Resume(ctx context.Context, runID string, resumeInput any) (S, error) {
+	var zero S
+	if g.checkpointer == nil {
+		return zero, fmt.Errorf("graph: resume run %s: no checkpointer configured", runID)
+	}
+
+	step, saved, err := g.checkpointer.Load(ctx, runID)
+	if err != nil {
+		return zero, fmt.Errorf("graph: load checkpoint for run %s: %w", runID, err)
+	}
+
+	rec, ok := saved.(checkpointRecord)
+	if !ok {
+		return zero, fmt.Errorf("graph: resume run %s: unexpected checkpoint payload %T", runID, saved)
+	}
+	state, ok := rec.State.(S)
+	if !ok {
+		return zero, fmt.Errorf("graph: resume run %s: checkpoint state has the wrong type", runID)
+	}
+
+	ctx = WithRunID(ctx, runID)
+	ctx = WithResumeInput(ctx, resumeInput)
+
+	return g.execute(ctx, rec.NextNode, state, step+1, nil)
+}
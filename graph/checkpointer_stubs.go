@@ -0,0 +1,21 @@
+package graph
+
+import "fmt"
+
+// NewSQLCheckpointer will return a [Checkpointer] backed by a SQL database
+// (one row per run ID, keyed on the latest step).
+//
+// TODO: not yet implemented; wire up against database/sql once the
+// checkpoints table schema is finalized.
+func NewSQLCheckpointer(dataSourceName string) (Checkpointer, error) {
+	return nil, fmt.Errorf("graph: SQL checkpointer not yet implemented")
+}
+
+// NewRedisCheckpointer will return a [Checkpointer] backed by Redis, storing
+// the latest checkpoint per run ID as a single key.
+//
+// TODO: not yet implemented; wire up against a Redis client once the key
+// schema is finalized.
+func NewRedisCheckpointer(addr string) (Checkpointer, error) {
+	return nil, fmt.Errorf("graph: Redis checkpointer not yet implemented")
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// execute runs nodes starting at start, optionally checkpointing after
+// every transition and emitting an [Event] per step. It backs [Graph.Run],
+// [Graph.Stream], and [Graph.Resume] so all three share the same stepping,
+// checkpointing, and interrupt behavior.
+//
+// emit may be nil, in which case events are simply discarded (the [Graph.Run]
+// case).
+func (g *Graph[S]) execute(ctx context.Context, start string, state S, startStep int, emit func(Event)) (S, error) {
+	if emit == nil {
+		emit = func(Event) {}
+	}
+
+	var runID string
+	checkpointing := false
+	if g.checkpointer != nil {
+		if id, ok := RunIDFromContext(ctx); ok {
+			runID, checkpointing = id, true
+		}
+	}
+
+	current := start
+	step := startStep
+
+	for current != "" {
+		select {
+		case <-ctx.Done():
+			emit(Event{Type: EventError, Node: current, Error: ctx.Err().Error()})
+			return state, ctx.Err()
+		default:
+		}
+
+		node, ok := g.nodes[current]
+		if !ok {
+			break
+		}
+
+		if checkpointing {
+			rec := checkpointRecord{NextNode: current, State: state}
+			if err := g.checkpointer.Save(ctx, runID, step, rec); err != nil {
+				err = fmt.Errorf("graph: save checkpoint for run %s: %w", runID, err)
+				emit(Event{Type: EventError, Node: current, Error: err.Error()})
+				return state, err
+			}
+			step++
+		}
+
+		nodeLogger := g.logger.With("graph_id", g.id, "node", current)
+		nodeLogger.Info("node started")
+		emit(Event{Type: EventNodeStarted, Node: current})
+
+		started := time.Now()
+		var err error
+		state, err = node.Execute(ctx, state)
+		duration := time.Since(started)
+		// Resume input is visible only to the first node executed after
+		// [Graph.Resume], the one being re-entered.
+		if _, resuming := ResumeFromContext(ctx); resuming {
+			ctx = context.WithValue(ctx, resumeKey{}, nil)
+		}
+		if err != nil {
+			var ie *interruptError
+			if errors.As(err, &ie) {
+				if checkpointing {
+					rec := checkpointRecord{
+						NextNode: current,
+						State:    state,
+						Waiting:  true,
+						Reason:   ie.reason,
+						Payload:  ie.payload,
+					}
+					if serr := g.checkpointer.Save(ctx, runID, step, rec); serr != nil {
+						serr = fmt.Errorf("graph: save interrupt checkpoint for run %s: %w", runID, serr)
+						nodeLogger.Error("checkpoint interrupt failed", "error", serr, "duration_ms", duration.Milliseconds())
+						emit(Event{Type: EventError, Node: current, Error: serr.Error()})
+						return state, serr
+					}
+				}
+				nodeLogger.Info("node interrupted", "reason", ie.reason, "duration_ms", duration.Milliseconds())
+				emit(Event{Type: EventWaiting, Node: current, Content: ie.reason, Payload: ie.payload})
+				return state, err
+			}
+
+			nodeLogger.Error("node failed", "error", err, "duration_ms", duration.Milliseconds())
+			emit(Event{Type: EventError, Node: current, Error: err.Error()})
+			return state, err
+		}
+
+		nodeLogger.Info("node finished", "duration_ms", duration.Milliseconds())
+		emit(Event{Type: EventNodeCompleted, Node: current, State: state})
+
+		current, err = g.route(ctx, current, node, state)
+		if err != nil {
+			nodeLogger.Error("routing failed", "error", err)
+			emit(Event{Type: EventError, Node: current, Error: err.Error()})
+			return state, err
+		}
+	}
+
+	emit(Event{Type: EventDone, State: state})
+	return state, nil
+}
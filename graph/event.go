@@ -0,0 +1,124 @@
+package graph
+
+import "context"
+
+// EventType identifies the kind of data carried by an [Event].
+type EventType string
+
+const (
+	// EventNodeStarted is emitted right before a node's Execute is called.
+	EventNodeStarted EventType = "node_started"
+
+	// EventNodeCompleted is emitted after a node's Execute returns
+	// successfully.
+	EventNodeCompleted EventType = "node_completed"
+
+	// EventStateDelta carries an incremental state update emitted by a node
+	// via the [Emitter] retrieved from its context.
+	EventStateDelta EventType = "state_delta"
+
+	// EventToken carries a token chunk. No built-in node emits this yet
+	// (none wraps an [llm.StreamProvider]); a custom node that consumes one
+	// should emit one EventToken per chunk via [EmitterFromContext].
+	EventToken EventType = "token"
+
+	// EventToolCallStart is emitted by [ToolNode] when it begins executing a
+	// tool call.
+	EventToolCallStart EventType = "tool_call_start"
+
+	// EventToolCallResult is emitted by [ToolNode] when a tool call
+	// finishes.
+	EventToolCallResult EventType = "tool_call_result"
+
+	// EventWaiting is the terminal event emitted when a node interrupts the
+	// run via [Interrupt]. The run is paused, not failed: a later
+	// [Graph.Resume] picks up where it left off.
+	EventWaiting EventType = "waiting"
+
+	// EventDone is the terminal event emitted when a run finishes
+	// successfully.
+	EventDone EventType = "done"
+
+	// EventError is the terminal event emitted when a run fails.
+	EventError EventType = "error"
+)
+
+// Event is a single entry in the stream returned by [Graph.Stream].
+//
+// Event is a tagged union: which fields are populated depends on Type.
+type Event struct {
+	// Type identifies the kind of event.
+	Type EventType `json:"type"`
+
+	// Node is the name of the node this event pertains to, when applicable.
+	Node string `json:"node,omitempty"`
+
+	// Content holds token text for EventToken, and the interrupt reason for
+	// EventWaiting.
+	Content string `json:"content,omitempty"`
+
+	// Payload carries the value passed to [Interrupt] for EventWaiting, e.g.
+	// the order or form a human needs to see to act on it.
+	Payload any `json:"payload,omitempty"`
+
+	// ToolCallID identifies the tool call for EventToolCallStart/Result.
+	ToolCallID string `json:"tool_call_id,omitempty"`
+
+	// ToolName is the tool name for EventToolCallStart.
+	ToolName string `json:"tool_name,omitempty"`
+
+	// ToolResult is the tool's output for EventToolCallResult.
+	ToolResult string `json:"tool_result,omitempty"`
+
+	// State carries the current state for EventNodeCompleted, EventStateDelta,
+	// and EventDone.
+	State any `json:"state,omitempty"`
+
+	// Error carries the failure message for EventError.
+	Error string `json:"error,omitempty"`
+}
+
+// Emitter lets a node push events onto the channel returned by
+// [Graph.Stream] without knowing about the outer channel. Retrieve the
+// emitter for the current execution with [EmitterFromContext].
+//
+// A nil *Emitter is valid and silently discards events, so code written
+// against [EmitterFromContext] behaves the same whether the graph is run
+// with [Graph.Run] or [Graph.Stream].
+type Emitter struct {
+	events chan<- Event
+}
+
+// Emit sends ev to the stream. It is a no-op if e is nil (i.e. the graph is
+// executing via [Graph.Run] rather than [Graph.Stream]) or the context
+// backing the stream has already been cancelled.
+func (e *Emitter) Emit(ctx context.Context, ev Event) {
+	if e == nil || e.events == nil {
+		return
+	}
+	select {
+	case e.events <- ev:
+	case <-ctx.Done():
+	}
+}
+
+type emitterKey struct{}
+
+// EmitterFromContext returns the [Emitter] for the current [Graph.Stream]
+// run, or nil if the graph is executing via [Graph.Run].
+//
+// Example:
+//
+//	func (n *MyNode) Execute(ctx context.Context, state *MyState) (*MyState, error) {
+//	    emitter := graph.EmitterFromContext(ctx)
+//	    emitter.Emit(ctx, graph.Event{Type: graph.EventToken, Content: "..."})
+//	    return state, nil
+//	}
+func EmitterFromContext(ctx context.Context) *Emitter {
+	e, _ := ctx.Value(emitterKey{}).(*Emitter)
+	return e
+}
+
+func withEmitter(ctx context.Context, e *Emitter) context.Context {
+	return context.WithValue(ctx, emitterKey{}, e)
+}
@@ -66,6 +66,8 @@ package graph
 
 import (
 	"context"
+
+	"github.com/duragraph/duragraph-go/log"
 )
 
 // Node is the interface that all graph nodes must implement.
@@ -122,10 +124,31 @@ type Router[S any] interface {
 // The type parameter S is the state type that flows through the graph.
 // It should typically be a pointer to a struct for efficient updates.
 type Graph[S any] struct {
-	id         string
-	nodes      map[string]Node[S]
-	edges      map[string][]string
-	entrypoint string
+	id           string
+	nodes        map[string]Node[S]
+	edges        map[string][]string
+	entrypoint   string
+	checkpointer Checkpointer
+	logger       log.Logger
+}
+
+// Option configures a [Graph] at construction time.
+type Option func(*graphConfig)
+
+type graphConfig struct {
+	logger log.Logger
+}
+
+// WithLogger sets the [log.Logger] the graph uses to record node start,
+// finish, duration, and errors. Default is a no-op logger.
+//
+// Example:
+//
+//	g := graph.New[*ChatState]("chat_agent", graph.WithLogger(log.New()))
+func WithLogger(logger log.Logger) Option {
+	return func(c *graphConfig) {
+		c.logger = logger
+	}
 }
 
 // New creates a new graph with the given ID.
@@ -136,11 +159,17 @@ type Graph[S any] struct {
 // Example:
 //
 //	g := graph.New[*ChatState]("chat_agent")
-func New[S any](id string) *Graph[S] {
+func New[S any](id string, opts ...Option) *Graph[S] {
+	cfg := graphConfig{logger: log.NewNop()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	return &Graph[S]{
-		id:    id,
-		nodes: make(map[string]Node[S]),
-		edges: make(map[string][]string),
+		id:     id,
+		nodes:  make(map[string]Node[S]),
+		edges:  make(map[string][]string),
+		logger: cfg.logger,
 	}
 }
 
@@ -190,10 +219,29 @@ func (g *Graph[S]) SetEntrypoint(name string) *Graph[S] {
 	return g
 }
 
+// SetCheckpointer configures where the graph persists its execution state.
+//
+// When set, [Graph.Run] and [Graph.Stream] save a checkpoint after every
+// node transition, keyed by the run ID carried in ctx (see [WithRunID]).
+// Without a run ID in ctx, or without a checkpointer, execution proceeds
+// without persisting anything. Returns the graph for method chaining.
+//
+// Example:
+//
+//	g.SetCheckpointer(graph.NewMemoryCheckpointer())
+func (g *Graph[S]) SetCheckpointer(cp Checkpointer) *Graph[S] {
+	g.checkpointer = cp
+	return g
+}
+
 // Run executes the graph starting from the entrypoint with the given initial state.
 //
 // Execution proceeds through nodes following edges or router decisions until:
 //   - A node returns an error
+//   - A node returns [Interrupt], in which case Run returns an error
+//     matching [ErrInterrupt] via errors.Is and the state as of the
+//     interrupt; recover the reason and payload with [InterruptReason] and
+//     [InterruptPayload], then continue with a later [Graph.Resume]
 //   - No more edges or router returns empty string
 //   - The context is cancelled
 //
@@ -209,45 +257,20 @@ func (g *Graph[S]) SetEntrypoint(name string) *Graph[S] {
 //	}
 //	fmt.Println(result.Result)
 func (g *Graph[S]) Run(ctx context.Context, state S) (S, error) {
-	current := g.entrypoint
-
-	for current != "" {
-		// Check for context cancellation
-		select {
-		case <-ctx.Done():
-			return state, ctx.Err()
-		default:
-		}
-
-		node, ok := g.nodes[current]
-		if !ok {
-			break
-		}
-
-		var err error
-		state, err = node.Execute(ctx, state)
-		if err != nil {
-			return state, err
-		}
-
-		// Check if node is a router
-		if router, ok := node.(Router[S]); ok {
-			next, err := router.Route(ctx, state)
-			if err != nil {
-				return state, err
-			}
-			current = next
-			continue
-		}
-
-		// Follow edge to next node
-		edges := g.edges[current]
-		if len(edges) > 0 {
-			current = edges[0]
-		} else {
-			current = ""
-		}
+	return g.execute(ctx, g.entrypoint, state, 0, nil)
+}
+
+// route determines the name of the node to execute after current, consulting
+// the node's [Router] implementation if present, otherwise following the
+// first outgoing edge. Returns an empty string when execution should stop.
+func (g *Graph[S]) route(ctx context.Context, current string, node Node[S], state S) (string, error) {
+	if router, ok := node.(Router[S]); ok {
+		return router.Route(ctx, state)
 	}
 
-	return state, nil
+	edges := g.edges[current]
+	if len(edges) > 0 {
+		return edges[0], nil
+	}
+	return "", nil
 }
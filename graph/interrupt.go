@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInterrupt is the sentinel a caller can match against with errors.Is to
+// tell an interrupted run apart from a failed one. Node implementations
+// should return the result of [Interrupt] rather than this sentinel
+// directly, so the reason and payload travel with it.
+var ErrInterrupt = errors.New("graph: run interrupted, awaiting resume")
+
+// interruptError carries the reason and payload for an [Interrupt] and
+// matches [ErrInterrupt] via errors.Is.
+type interruptError struct {
+	reason  string
+	payload any
+}
+
+func (e *interruptError) Error() string {
+	return fmt.Sprintf("graph: interrupted: %s", e.reason)
+}
+
+func (e *interruptError) Is(target error) bool { return target == ErrInterrupt }
+
+// Interrupt pauses graph execution at the current node: the node returns
+// this error, [Graph.Run] (or [Graph.Stream]) persists a "waiting"
+// checkpoint (when checkpointing is enabled) and stops with an error
+// matching [ErrInterrupt] (or a terminal [EventWaiting]) rather than
+// treating the run as failed, and a later [Graph.Resume]
+// re-enters the same node with resumeInput available via
+// [ResumeFromContext].
+//
+// This is the primitive for approval gates, form fills, and any other
+// long-running agent step that needs a human (or another system) to supply
+// input before the graph can continue.
+//
+// Example:
+//
+//	func (n *ApprovalNode) Execute(ctx context.Context, state *OrderState) (*OrderState, error) {
+//	    if rc, ok := graph.ResumeFromContext(ctx); ok {
+//	        state.Approved = rc.Input.(bool)
+//	        return state, nil
+//	    }
+//	    return state, graph.Interrupt("awaiting manager approval", state.Order)
+//	}
+func Interrupt(reason string, payload any) error {
+	return &interruptError{reason: reason, payload: payload}
+}
+
+// InterruptReason returns the reason passed to [Interrupt] if err matches
+// [ErrInterrupt], and false otherwise. Use this to recover the reason from
+// an error returned by [Graph.Run] or [Graph.Resume] without depending on
+// the unexported interrupt error type.
+func InterruptReason(err error) (string, bool) {
+	var ie *interruptError
+	if errors.As(err, &ie) {
+		return ie.reason, true
+	}
+	return "", false
+}
+
+// InterruptPayload returns the payload passed to [Interrupt] if err matches
+// [ErrInterrupt], and false otherwise. Use this to recover the payload (the
+// order awaiting approval, the form awaiting input, ...) from an error
+// returned by [Graph.Run] or [Graph.Resume].
+func InterruptPayload(err error) (any, bool) {
+	var ie *interruptError
+	if errors.As(err, &ie) {
+		return ie.payload, true
+	}
+	return nil, false
+}
+
+// ResumeContext carries the input supplied to [Graph.Resume] into the node
+// that's resuming.
+type ResumeContext struct {
+	// Input is the resumeInput value passed to [Graph.Resume].
+	Input any
+}
+
+type resumeKey struct{}
+
+// WithResumeInput attaches a [ResumeContext] to ctx. [Graph.Resume] calls
+// this internally; node implementations read it back with
+// [ResumeFromContext].
+func WithResumeInput(ctx context.Context, input any) context.Context {
+	return context.WithValue(ctx, resumeKey{}, &ResumeContext{Input: input})
+}
+
+// ResumeFromContext returns the [ResumeContext] for the current execution,
+// if this node is being re-entered via [Graph.Resume].
+func ResumeFromContext(ctx context.Context) (*ResumeContext, bool) {
+	rc, ok := ctx.Value(resumeKey{}).(*ResumeContext)
+	return rc, ok
+}
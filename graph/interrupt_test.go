@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type approvalState struct {
+	Approved bool
+	Steps    []string
+}
+
+type recordNode struct{ name string }
+
+func (n *recordNode) Execute(ctx context.Context, s *approvalState) (*approvalState, error) {
+	s.Steps = append(s.Steps, n.name)
+	return s, nil
+}
+
+type approvalNode struct{}
+
+func (n *approvalNode) Execute(ctx context.Context, s *approvalState) (*approvalState, error) {
+	if rc, ok := ResumeFromContext(ctx); ok {
+		s.Approved = rc.Input.(bool)
+		return s, nil
+	}
+	return s, Interrupt("needs approval", nil)
+}
+
+func TestInterruptThenResume(t *testing.T) {
+	g := New[*approvalState]("approval_test")
+	g.AddNode("start", &recordNode{name: "start"})
+	g.AddNode("approve", &approvalNode{})
+	g.AddNode("finish", &recordNode{name: "finish"})
+	g.AddEdge("start", "approve")
+	g.AddEdge("approve", "finish")
+	g.SetEntrypoint("start")
+	g.SetCheckpointer(NewMemoryCheckpointer())
+
+	ctx := WithRunID(context.Background(), "run-1")
+	state, err := g.Run(ctx, &approvalState{})
+	if !errors.Is(err, ErrInterrupt) {
+		t.Fatalf("Run: expected ErrInterrupt, got %v", err)
+	}
+	if state.Approved {
+		t.Fatalf("Run: state should not be approved before resume")
+	}
+
+	resumed, err := g.Resume(context.Background(), "run-1", true)
+	if err != nil {
+		t.Fatalf("Resume: unexpected error: %v", err)
+	}
+	if !resumed.Approved {
+		t.Fatalf("Resume: expected state to be approved")
+	}
+	want := []string{"start", "finish"}
+	if len(resumed.Steps) != len(want) || resumed.Steps[0] != want[0] || resumed.Steps[1] != want[1] {
+		t.Fatalf("Resume: unexpected steps: %v", resumed.Steps)
+	}
+}
@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryCheckpointer is an in-process [Checkpointer]. It's useful for tests
+// and single-process deployments; checkpoints don't survive the process
+// exiting, so it can't back [Graph.Resume] across a restart on its own.
+type MemoryCheckpointer struct {
+	mu    sync.Mutex
+	saved map[string]memoryCheckpoint
+}
+
+type memoryCheckpoint struct {
+	step  int
+	state any
+}
+
+// NewMemoryCheckpointer creates an empty in-memory checkpointer.
+func NewMemoryCheckpointer() *MemoryCheckpointer {
+	return &MemoryCheckpointer{
+		saved: make(map[string]memoryCheckpoint),
+	}
+}
+
+// Save implements [Checkpointer].
+func (c *MemoryCheckpointer) Save(ctx context.Context, runID string, step int, state any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.saved[runID] = memoryCheckpoint{step: step, state: state}
+	return nil
+}
+
+// Load implements [Checkpointer].
+func (c *MemoryCheckpointer) Load(ctx context.Context, runID string) (int, any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cp, ok := c.saved[runID]
+	if !ok {
+		return 0, nil, fmt.Errorf("graph: no checkpoint for run %s", runID)
+	}
+	return cp.step, cp.state, nil
+}
@@ -0,0 +1,37 @@
+package graph
+
+import "context"
+
+// Stream executes the graph like [Graph.Run] but returns a channel of
+// [Event]s describing progress as it happens: node lifecycle events, state
+// deltas and token chunks pushed by nodes via [EmitterFromContext], and a
+// terminal [EventDone], [EventWaiting] (the run was [Interrupt]ed and is
+// awaiting [Graph.Resume]), or [EventError].
+//
+// The returned channel is closed after the terminal event is sent. Stream
+// returns its error (if any) via the terminal EventError rather than as a
+// second return value, since execution happens in a goroutine.
+//
+// Example:
+//
+//	events, err := g.Stream(ctx, &ChatState{Messages: []string{"Hello"}})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for ev := range events {
+//	    fmt.Printf("%s: %+v\n", ev.Type, ev)
+//	}
+func (g *Graph[S]) Stream(ctx context.Context, state S) (<-chan Event, error) {
+	events := make(chan Event)
+	emitter := &Emitter{events: events}
+
+	go func() {
+		defer close(events)
+		ctx := withEmitter(ctx, emitter)
+		g.execute(ctx, g.entrypoint, state, 0, func(ev Event) {
+			emitter.Emit(ctx, ev)
+		})
+	}()
+
+	return events, nil
+}
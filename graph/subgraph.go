@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubGraph runs a child [Graph] as a single [Node] inside a parent graph,
+// projecting the parent's state into the child's state and merging the
+// child's result back.
+//
+// This is the composition primitive for packaging a reusable agent
+// (retrieval, planner, critic, ...) as its own graph and dropping it into a
+// larger workflow without flattening every node into one namespace.
+//
+// Construct a SubGraph with [NewSubGraph], then attach it to a parent graph
+// with [AddSubgraph].
+type SubGraph[Parent, Child any] struct {
+	child   *Graph[Child]
+	project func(Parent) Child
+	merge   func(Parent, Child) Parent
+
+	// parentID and nodeName are set by [AddSubgraph] so Execute can
+	// namespace its error messages and the node names of the events it
+	// forwards under parentID/childID/nodeName.
+	parentID string
+	nodeName string
+}
+
+// NewSubGraph creates a SubGraph that runs child, using project to derive
+// the child's initial state from the parent's state and merge to fold the
+// child's final state back into the parent's.
+//
+// Example:
+//
+//	retrieval := graph.NewSubGraph(retrievalGraph,
+//	    func(s *AgentState) *RetrievalState {
+//	        return &RetrievalState{Query: s.Query}
+//	    },
+//	    func(s *AgentState, r *RetrievalState) *AgentState {
+//	        s.Documents = r.Documents
+//	        return s
+//	    },
+//	)
+func NewSubGraph[Parent, Child any](
+	child *Graph[Child],
+	project func(Parent) Child,
+	merge func(Parent, Child) Parent,
+) *SubGraph[Parent, Child] {
+	return &SubGraph[Parent, Child]{
+		child:   child,
+		project: project,
+		merge:   merge,
+	}
+}
+
+// AddSubgraph attaches sg to parent under name, so its error messages carry
+// the id "parentID/childID/name" and the node names of the child events it
+// forwards are prefixed with "parentID/childID/name/". Log entries written
+// by the child graph are not namespaced: they carry the child's own graph
+// ID and node names.
+//
+// This is a package-level function rather than a [Graph] method because Go
+// methods can't introduce the extra Child type parameter SubGraph needs.
+//
+// Example:
+//
+//	g := graph.New[*AgentState]("agent")
+//	graph.AddSubgraph(g, "retrieve", retrieval)
+//	g.AddEdge("retrieve", "respond")
+func AddSubgraph[Parent, Child any](parent *Graph[Parent], name string, sg *SubGraph[Parent, Child]) *Graph[Parent] {
+	sg.parentID = parent.id
+	sg.nodeName = name
+	return parent.AddNode(name, sg)
+}
+
+// id returns the namespaced identifier used in errors and event node names:
+// "parentID/childID/nodeName". Falls back to the child graph's own ID if
+// the SubGraph hasn't been attached via [AddSubgraph] yet.
+func (sg *SubGraph[Parent, Child]) id() string {
+	if sg.parentID == "" && sg.nodeName == "" {
+		return sg.child.ID()
+	}
+	return fmt.Sprintf("%s/%s/%s", sg.parentID, sg.child.ID(), sg.nodeName)
+}
+
+// Execute projects parent into the child's state, runs the child graph to
+// completion, and merges the result back into parent.
+//
+// If ctx carries an [Emitter] (i.e. the parent is executing via
+// [Graph.Stream]), Execute streams the child graph instead of just running
+// it, forwarding each child [Event] with its Node field namespaced under
+// this SubGraph's id so control-plane reporting can tell which subgraph and
+// node produced it.
+//
+// If the child interrupts (see [Interrupt]), Execute returns an error
+// matching [ErrInterrupt] instead of merging, so the parent graph treats
+// the subgraph node itself as interrupted rather than silently finishing —
+// this works whether the parent is running via [Graph.Run] (the child's own
+// interrupt error propagates from [Graph.Run]) or [Graph.Stream] (handled
+// in the EventWaiting case below).
+func (sg *SubGraph[Parent, Child]) Execute(ctx context.Context, parent Parent) (Parent, error) {
+	child := sg.project(parent)
+
+	emitter := EmitterFromContext(ctx)
+	if emitter == nil {
+		result, err := sg.child.Run(ctx, child)
+		if err != nil {
+			return parent, fmt.Errorf("graph: subgraph %s: %w", sg.id(), err)
+		}
+		return sg.merge(parent, result), nil
+	}
+
+	events, err := sg.child.Stream(ctx, child)
+	if err != nil {
+		return parent, fmt.Errorf("graph: subgraph %s: %w", sg.id(), err)
+	}
+
+	result := child
+	for ev := range events {
+		ev.Node = sg.id() + "/" + ev.Node
+		emitter.Emit(ctx, ev)
+
+		switch ev.Type {
+		case EventDone:
+			if s, ok := ev.State.(Child); ok {
+				result = s
+			}
+		case EventWaiting:
+			return parent, Interrupt(ev.Content, ev.Payload)
+		case EventError:
+			return parent, fmt.Errorf("graph: subgraph %s: %s", sg.id(), ev.Error)
+		}
+	}
+
+	return sg.merge(parent, result), nil
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/duragraph/duragraph-go/llm"
+)
+
+// DefaultMaxToolIterations is the default number of completion/tool-call
+// round trips a [ToolNode] will perform before giving up.
+const DefaultMaxToolIterations = 10
+
+// ErrMaxToolIterations is returned by [ToolNode.Execute] when the
+// completion/tool-call loop reaches its MaxIterations limit without the
+// model settling on a final answer.
+var ErrMaxToolIterations = fmt.Errorf("graph: exceeded max tool iterations")
+
+// ToolNodeOption configures a [ToolNode].
+type ToolNodeOption func(*toolNodeConfig)
+
+type toolNodeConfig struct {
+	maxIterations int
+	opts          []llm.Option
+}
+
+// WithMaxIterations caps the number of completion/tool-call round trips a
+// [ToolNode] will perform. Default is [DefaultMaxToolIterations].
+func WithMaxIterations(n int) ToolNodeOption {
+	return func(c *toolNodeConfig) {
+		c.maxIterations = n
+	}
+}
+
+// WithCompletionOptions sets additional [llm.Option]s (model, temperature,
+// etc.) to use on every completion made by the node.
+func WithCompletionOptions(opts ...llm.Option) ToolNodeOption {
+	return func(c *toolNodeConfig) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// ToolNode is a [Node] that drives an executable tool-call loop: it calls
+// Provider.Complete, runs any requested tool calls against registry, appends
+// the results back into the conversation, and repeats until the model stops
+// asking for tools.
+//
+// ToolNode needs to read and write the conversation held in the state S, so
+// it's constructed with a pair of accessor functions rather than requiring S
+// to implement an interface. This keeps ToolNode usable with whatever state
+// shape a node's graph already has.
+//
+// Example:
+//
+//	type ChatState struct {
+//	    Messages []llm.Message
+//	}
+//
+//	node := graph.NewToolNode(provider, registry,
+//	    func(s *ChatState) []llm.Message { return s.Messages },
+//	    func(s *ChatState, msgs []llm.Message) *ChatState {
+//	        s.Messages = msgs
+//	        return s
+//	    },
+//	)
+//	g.AddNode("agent", node)
+type ToolNode[S any] struct {
+	provider llm.Provider
+	registry *llm.ToolRegistry
+	messages func(S) []llm.Message
+	setter   func(S, []llm.Message) S
+	config   toolNodeConfig
+}
+
+// NewToolNode creates a ToolNode that completes against provider, dispatches
+// tool calls through registry, and reads/writes the conversation in state
+// via messages and setMessages.
+func NewToolNode[S any](
+	provider llm.Provider,
+	registry *llm.ToolRegistry,
+	messages func(S) []llm.Message,
+	setMessages func(S, []llm.Message) S,
+	opts ...ToolNodeOption,
+) *ToolNode[S] {
+	cfg := toolNodeConfig{
+		maxIterations: DefaultMaxToolIterations,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &ToolNode[S]{
+		provider: provider,
+		registry: registry,
+		messages: messages,
+		setter:   setMessages,
+		config:   cfg,
+	}
+}
+
+// Execute runs the completion/tool-call loop and returns the state with the
+// final conversation (including any tool results) written back.
+func (n *ToolNode[S]) Execute(ctx context.Context, state S) (S, error) {
+	messages := n.messages(state)
+	opts := append([]llm.Option{llm.WithTools(n.registry.Tools())}, n.config.opts...)
+	emitter := EmitterFromContext(ctx)
+
+	for i := 0; i < n.config.maxIterations; i++ {
+		resp, err := n.provider.Complete(ctx, messages, opts...)
+		if err != nil {
+			return state, err
+		}
+
+		messages = append(messages, llm.Message{
+			Role:      "assistant",
+			Content:   resp.Content,
+			ToolCalls: resp.ToolCalls,
+		})
+
+		if resp.FinishReason != "tool_calls" || len(resp.ToolCalls) == 0 {
+			return n.setter(state, messages), nil
+		}
+
+		for _, call := range resp.ToolCalls {
+			emitter.Emit(ctx, Event{Type: EventToolCallStart, ToolCallID: call.ID, ToolName: call.Name})
+		}
+
+		toolMessages, err := n.registry.Dispatch(ctx, resp.ToolCalls)
+		if err != nil {
+			return n.setter(state, messages), err
+		}
+		for _, tm := range toolMessages {
+			emitter.Emit(ctx, Event{Type: EventToolCallResult, ToolCallID: tm.ToolCallID, ToolResult: tm.Content})
+		}
+		messages = append(messages, toolMessages...)
+	}
+
+	return n.setter(state, messages), ErrMaxToolIterations
+}
+
+// GenAIAttributes returns tracing attributes describing this node's model
+// configuration, following the OpenTelemetry gen_ai.* semantic conventions.
+// Tracing wrappers (see the duragraph/otel package) pick this up via a type
+// assertion and attach the attributes to the node's span.
+func (n *ToolNode[S]) GenAIAttributes() map[string]string {
+	attrs := map[string]string{
+		"gen_ai.operation.name": "chat",
+	}
+	for _, opt := range n.config.opts {
+		var cfg llm.RequestConfig
+		opt(&cfg)
+		if cfg.Model != "" {
+			attrs["gen_ai.request.model"] = cfg.Model
+		}
+	}
+	return attrs
+}
@@ -60,6 +60,11 @@ type Message struct {
 
 	// ToolCallID is set when Role is "tool" to identify which tool call this responds to.
 	ToolCallID string `json:"tool_call_id,omitempty"`
+
+	// ToolCalls is set on "assistant" messages that requested tool calls, so
+	// the calls stay attached to the turn that made them across subsequent
+	// completion requests.
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
 }
 
 // Response represents an LLM completion response.
@@ -170,6 +175,12 @@ type Tool struct {
 
 	// Parameters is a JSON Schema describing the tool's parameters.
 	Parameters map[string]any `json:"parameters"`
+
+	// Impl is the callable implementation for this tool. It is optional:
+	// a Tool with a nil Impl can still be advertised to the model via
+	// [WithTools], but [ToolRegistry.Dispatch] will not be able to execute
+	// it. Set Impl when registering the tool with a [ToolRegistry].
+	Impl func(ctx context.Context, args map[string]any) (string, error) `json:"-"`
 }
 
 // WithModel sets the model to use for completion.
@@ -0,0 +1,136 @@
+package llm
+
+import (
+	"context"
+	"time"
+
+	"github.com/duragraph/duragraph-go/log"
+)
+
+// LoggingOption configures a provider wrapped with [NewLoggingProvider].
+type LoggingOption func(*loggingConfig)
+
+type loggingConfig struct {
+	logPrompts bool
+}
+
+// WithLogPrompts controls whether message content is included in log output.
+// Default is false, since prompts and completions often contain user data.
+//
+// Example:
+//
+//	llm.NewLoggingProvider(client, logger, llm.WithLogPrompts(true))
+func WithLogPrompts(enabled bool) LoggingOption {
+	return func(c *loggingConfig) {
+		c.logPrompts = enabled
+	}
+}
+
+// NewLoggingProvider wraps inner so every [Provider.Complete] (and
+// [StreamProvider.Stream], if inner supports it) call logs its model,
+// latency, token usage, and outcome via logger. Successful Complete calls
+// log the model named in the response and Stream calls log the model
+// requested with [WithModel], if any; a failed Complete call logs no model.
+//
+// The returned Provider implements [StreamProvider] if and only if inner
+// does, so callers that type-assert for streaming support see the same
+// result as they would against inner directly.
+//
+// Example:
+//
+//	client := openai.New()
+//	logged := llm.NewLoggingProvider(client, logger)
+func NewLoggingProvider(inner Provider, logger log.Logger, opts ...LoggingOption) Provider {
+	cfg := loggingConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	p := &loggingProvider{inner: inner, logger: logger, config: cfg}
+	if stream, ok := inner.(StreamProvider); ok {
+		return &loggingStreamProvider{loggingProvider: p, inner: stream}
+	}
+	return p
+}
+
+type loggingProvider struct {
+	inner  Provider
+	logger log.Logger
+	config loggingConfig
+}
+
+func (p *loggingProvider) Complete(ctx context.Context, messages []Message, opts ...Option) (*Response, error) {
+	fields := []any{"message_count", len(messages)}
+	if p.config.logPrompts {
+		fields = append(fields, "messages", messages)
+	}
+	logger := p.logger.With(fields...)
+
+	started := time.Now()
+	resp, err := p.inner.Complete(ctx, messages, opts...)
+	duration := time.Since(started)
+
+	if err != nil {
+		logger.Error("llm completion failed", "error", err, "duration_ms", duration.Milliseconds())
+		return resp, err
+	}
+
+	logger.Info("llm completion",
+		"model", resp.Model,
+		"finish_reason", resp.FinishReason,
+		"prompt_tokens", resp.Usage.PromptTokens,
+		"completion_tokens", resp.Usage.CompletionTokens,
+		"duration_ms", duration.Milliseconds(),
+	)
+	return resp, nil
+}
+
+type loggingStreamProvider struct {
+	*loggingProvider
+	inner StreamProvider
+}
+
+func (p *loggingStreamProvider) Stream(ctx context.Context, messages []Message, opts ...Option) (<-chan StreamChunk, error) {
+	fields := []any{"message_count", len(messages)}
+	var req RequestConfig
+	for _, opt := range opts {
+		opt(&req)
+	}
+	if req.Model != "" {
+		fields = append(fields, "model", req.Model)
+	}
+	if p.config.logPrompts {
+		fields = append(fields, "messages", messages)
+	}
+	logger := p.logger.With(fields...)
+
+	started := time.Now()
+	chunks, err := p.inner.Stream(ctx, messages, opts...)
+	if err != nil {
+		logger.Error("llm stream failed to start", "error", err, "duration_ms", time.Since(started).Milliseconds())
+		return nil, err
+	}
+
+	out := make(chan StreamChunk)
+	go func() {
+		defer close(out)
+		var usage *Usage
+		var finishReason string
+		for chunk := range chunks {
+			if chunk.Usage != nil {
+				usage = chunk.Usage
+			}
+			if chunk.FinishReason != "" {
+				finishReason = chunk.FinishReason
+			}
+			out <- chunk
+		}
+
+		fields := []any{"finish_reason", finishReason, "duration_ms", time.Since(started).Milliseconds()}
+		if usage != nil {
+			fields = append(fields, "prompt_tokens", usage.PromptTokens, "completion_tokens", usage.CompletionTokens)
+		}
+		logger.Info("llm stream finished", fields...)
+	}()
+	return out, nil
+}
@@ -0,0 +1,103 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ToolRegistry holds a set of callable [Tool] definitions and dispatches
+// [ToolCall]s from a [Response] to their registered [Tool.Impl].
+//
+// A ToolRegistry is safe for concurrent use.
+//
+// Example:
+//
+//	reg := llm.NewToolRegistry()
+//	reg.Register(llm.Tool{
+//	    Name:        "get_weather",
+//	    Description: "Get the current weather",
+//	    Parameters:  weatherSchema,
+//	    Impl: func(ctx context.Context, args map[string]any) (string, error) {
+//	        return weather.Lookup(args["location"].(string))
+//	    },
+//	})
+//
+//	resp, err := client.Complete(ctx, messages, llm.WithTools(reg.Tools()))
+//	results, err := reg.Dispatch(ctx, resp.ToolCalls)
+type ToolRegistry struct {
+	mu    sync.RWMutex
+	tools map[string]Tool
+}
+
+// NewToolRegistry creates an empty tool registry.
+func NewToolRegistry() *ToolRegistry {
+	return &ToolRegistry{
+		tools: make(map[string]Tool),
+	}
+}
+
+// Register adds a tool to the registry, keyed by its Name.
+//
+// Registering a tool with a name that already exists overwrites the
+// previous entry.
+func (r *ToolRegistry) Register(tool Tool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tools[tool.Name] = tool
+}
+
+// Tools returns the registered tools as a slice suitable for [WithTools].
+//
+// The order of the returned slice is not guaranteed.
+func (r *ToolRegistry) Tools() []Tool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tools := make([]Tool, 0, len(r.tools))
+	for _, tool := range r.tools {
+		tools = append(tools, tool)
+	}
+	return tools
+}
+
+// Dispatch runs the registered Impl for each call and returns one "tool"
+// [Message] per call, in the same order, suitable for appending back into
+// the conversation.
+//
+// If a call references a tool that isn't registered, or a registered tool
+// has no Impl, Dispatch returns an error message as the tool's content
+// rather than failing the whole batch, so the model can see and react to
+// the failure.
+func (r *ToolRegistry) Dispatch(ctx context.Context, calls []ToolCall) ([]Message, error) {
+	messages := make([]Message, len(calls))
+
+	for i, call := range calls {
+		r.mu.RLock()
+		tool, ok := r.tools[call.Name]
+		r.mu.RUnlock()
+
+		var content string
+		switch {
+		case !ok:
+			content = fmt.Sprintf("error: unknown tool %q", call.Name)
+		case tool.Impl == nil:
+			content = fmt.Sprintf("error: tool %q has no implementation", call.Name)
+		default:
+			result, err := tool.Impl(ctx, call.Arguments)
+			if err != nil {
+				content = fmt.Sprintf("error: %s", err)
+			} else {
+				content = result
+			}
+		}
+
+		messages[i] = Message{
+			Role:       "tool",
+			Content:    content,
+			ToolCallID: call.ID,
+		}
+	}
+
+	return messages, nil
+}
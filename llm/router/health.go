@@ -0,0 +1,60 @@
+package router
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	baseCooldown = time.Second
+	maxCooldown  = 5 * time.Minute
+	// maxErrorStreak bounds the streak used to compute cooldown, so the
+	// exponential backoff can't overflow before it's clamped to maxCooldown.
+	maxErrorStreak = 20
+)
+
+// health tracks the rolling error state of a single provider entry and
+// whether it's currently in its cooldown window.
+type health struct {
+	mu             sync.Mutex
+	errorStreak    int
+	unhealthyUntil time.Time
+}
+
+// markUnhealthy records a failure and starts (or extends) an exponential
+// cooldown window, capped at maxCooldown.
+func (h *health) markUnhealthy() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.errorStreak < maxErrorStreak {
+		h.errorStreak++
+	}
+	h.unhealthyUntil = time.Now().Add(cooldownFor(h.errorStreak))
+}
+
+// markHealthy decays the error streak on a successful call.
+func (h *health) markHealthy() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.errorStreak > 0 {
+		h.errorStreak--
+	}
+}
+
+// unhealthy reports whether the entry is still within its cooldown window.
+func (h *health) unhealthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return time.Now().Before(h.unhealthyUntil)
+}
+
+func cooldownFor(streak int) time.Duration {
+	d := baseCooldown << streak
+	if d <= 0 || d > maxCooldown {
+		d = maxCooldown
+	}
+	return d
+}
@@ -0,0 +1,336 @@
+// Package router provides a composite [llm.Provider] that spreads requests
+// across multiple underlying providers with health-tracked failover.
+//
+// # Basic Usage
+//
+//	p := router.New(
+//	    router.Entry{Name: "openai", Provider: openai.New(), Priority: 0},
+//	    router.Entry{Name: "anthropic", Provider: anthropic.New(), Priority: 1},
+//	)
+//
+//	resp, err := p.Complete(ctx, messages, llm.WithModel("gpt-4o-mini"))
+//
+// If the "openai" entry is unhealthy (recent transport errors, 5xx, 429, or
+// context-deadline failures), the router transparently retries the request
+// against "anthropic" instead, optionally rewriting the model via
+// [WithFallbackModel].
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/duragraph/duragraph-go/llm"
+)
+
+// Strategy selects how Router orders healthy entries before trying them.
+type Strategy int
+
+const (
+	// Priority tries entries in order of ascending Entry.Priority (lowest
+	// first). This is the default.
+	Priority Strategy = iota
+
+	// RoundRobin rotates the starting entry on each call, spreading load
+	// evenly across all healthy entries that accept the requested model.
+	RoundRobin
+
+	// LeastLatency tries the entry with the lowest recorded mean latency
+	// first. Entries with no samples yet are tried before those with data,
+	// so the router can establish a baseline for them.
+	LeastLatency
+)
+
+// Entry binds a name to an underlying provider for use with [New].
+type Entry struct {
+	// Name identifies this entry in logs and [Router.Stats].
+	Name string
+
+	// Provider is the underlying LLM provider.
+	Provider llm.Provider
+
+	// Priority orders entries for the [Priority] strategy. Lower values are
+	// tried first.
+	Priority int
+
+	// Weight is reserved for weighted load distribution between entries of
+	// equal priority. No [Strategy] reads it, and a zero Weight is left as
+	// zero.
+	Weight int
+
+	// Models, if non-empty, restricts this entry to requests whose
+	// [llm.WithModel] matches one of these names. An empty Models accepts
+	// any model.
+	Models []string
+}
+
+// Option configures a [Router]. Apply options with [Router.Configure].
+type Option func(*routerConfig)
+
+type routerConfig struct {
+	strategy      Strategy
+	fallbackModel map[string]string
+}
+
+// WithStrategy sets how the router orders entries before trying them.
+// Default is [Priority].
+func WithStrategy(s Strategy) Option {
+	return func(c *routerConfig) {
+		c.strategy = s
+	}
+}
+
+// WithFallbackModel rewrites the requested model when failing over to a
+// later entry. For example, a request for "gpt-4o-mini" that fails over
+// from an unhealthy OpenAI entry can be rewritten to "claude-3-haiku" on
+// the next entry tried.
+func WithFallbackModel(models map[string]string) Option {
+	return func(c *routerConfig) {
+		c.fallbackModel = models
+	}
+}
+
+// StatusError represents an HTTP-style status code from a provider,
+// letting Router classify retryable failures (5xx, 429) from terminal ones
+// (other 4xx). Provider implementations that wrap transport errors should
+// return a *StatusError so the router fails over correctly.
+type StatusError struct {
+	Code int
+	Err  error
+}
+
+func (e *StatusError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("router: status %d: %s", e.Code, e.Err)
+	}
+	return fmt.Sprintf("router: status %d", e.Code)
+}
+
+func (e *StatusError) Unwrap() error { return e.Err }
+
+// Router is a composite [llm.Provider] and [llm.StreamProvider] that fails
+// over between a set of underlying providers based on tracked health.
+//
+// Construct a Router with [New].
+type Router struct {
+	entries []Entry
+	health  []*health
+	stats   []*providerStats
+	config  routerConfig
+	rrNext  atomic.Uint64
+}
+
+// New returns a composite [llm.Provider] that tries providers in order,
+// failing over on transport errors, 5xx, 429, and context-deadline errors,
+// while tracking each entry's health.
+func New(entries ...Entry) *Router {
+	r := &Router{
+		entries: entries,
+		health:  make([]*health, len(entries)),
+		stats:   make([]*providerStats, len(entries)),
+	}
+	for i := range entries {
+		r.health[i] = &health{}
+		r.stats[i] = &providerStats{}
+	}
+	return r
+}
+
+// Configure applies opts to r and returns r for chaining. Call it before
+// the router is used.
+//
+// Example:
+//
+//	p := router.New(entries...).Configure(router.WithStrategy(router.RoundRobin))
+func (r *Router) Configure(opts ...Option) *Router {
+	for _, opt := range opts {
+		opt(&r.config)
+	}
+	return r
+}
+
+// requestModel applies opts to a fresh RequestConfig so the router can
+// inspect the requested model without depending on any particular
+// provider's internals.
+func requestModel(opts []llm.Option) string {
+	cfg := &llm.RequestConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg.Model
+}
+
+func (r *Router) accepts(idx int, model string) bool {
+	models := r.entries[idx].Models
+	if len(models) == 0 || model == "" {
+		return true
+	}
+	for _, m := range models {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+// order returns entry indices in the order they should be tried, per the
+// configured [Strategy].
+func (r *Router) order() []int {
+	idx := make([]int, len(r.entries))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	switch r.config.strategy {
+	case RoundRobin:
+		start := int(r.rrNext.Add(1)-1) % len(idx)
+		idx = append(idx[start:], idx[:start]...)
+	case LeastLatency:
+		sort.SliceStable(idx, func(a, b int) bool {
+			return r.stats[idx[a]].meanLatency() < r.stats[idx[b]].meanLatency()
+		})
+	default: // Priority
+		sort.SliceStable(idx, func(a, b int) bool {
+			return r.entries[idx[a]].Priority < r.entries[idx[b]].Priority
+		})
+	}
+	return idx
+}
+
+// Complete implements [llm.Provider] by trying entries in order, skipping
+// unhealthy ones, and failing over to the next entry on a failoverable
+// error.
+func (r *Router) Complete(ctx context.Context, messages []llm.Message, opts ...llm.Option) (*llm.Response, error) {
+	model := requestModel(opts)
+	order := r.order()
+
+	var lastErr error
+	triedAny := false
+	for attempt, idx := range order {
+		if !r.accepts(idx, model) || r.health[idx].unhealthy() {
+			continue
+		}
+		triedAny = true
+
+		callOpts := opts
+		if attempt > 0 && model != "" {
+			if fallback, ok := r.config.fallbackModel[model]; ok {
+				callOpts = append(append([]llm.Option{}, opts...), llm.WithModel(fallback))
+			}
+		}
+
+		start := time.Now()
+		resp, err := r.entries[idx].Provider.Complete(ctx, messages, callOpts...)
+		if err == nil {
+			r.health[idx].markHealthy()
+			r.stats[idx].recordSuccess(time.Since(start))
+			return resp, nil
+		}
+
+		r.stats[idx].recordError()
+		lastErr = err
+		if !isFailoverable(err) {
+			return nil, err
+		}
+		r.health[idx].markUnhealthy()
+	}
+
+	if !triedAny {
+		return nil, fmt.Errorf("router: no healthy provider accepts model %q", model)
+	}
+	return nil, fmt.Errorf("router: all providers failed: %w", lastErr)
+}
+
+// Stream implements [llm.StreamProvider] with the same ordering and
+// failover behavior as Complete. Providers that don't implement
+// [llm.StreamProvider] are skipped.
+func (r *Router) Stream(ctx context.Context, messages []llm.Message, opts ...llm.Option) (<-chan llm.StreamChunk, error) {
+	model := requestModel(opts)
+	order := r.order()
+
+	var lastErr error
+	triedAny := false
+	for attempt, idx := range order {
+		if !r.accepts(idx, model) || r.health[idx].unhealthy() {
+			continue
+		}
+
+		streamer, ok := r.entries[idx].Provider.(llm.StreamProvider)
+		if !ok {
+			continue
+		}
+		triedAny = true
+
+		callOpts := opts
+		if attempt > 0 && model != "" {
+			if fallback, ok := r.config.fallbackModel[model]; ok {
+				callOpts = append(append([]llm.Option{}, opts...), llm.WithModel(fallback))
+			}
+		}
+
+		start := time.Now()
+		chunks, err := streamer.Stream(ctx, messages, callOpts...)
+		if err == nil {
+			r.health[idx].markHealthy()
+			r.stats[idx].recordSuccess(time.Since(start))
+			return chunks, nil
+		}
+
+		r.stats[idx].recordError()
+		lastErr = err
+		if !isFailoverable(err) {
+			return nil, err
+		}
+		r.health[idx].markUnhealthy()
+	}
+
+	if !triedAny {
+		return nil, fmt.Errorf("router: no healthy streaming provider accepts model %q", model)
+	}
+	return nil, fmt.Errorf("router: all streaming providers failed: %w", lastErr)
+}
+
+// Stats returns per-provider request counts, error rates, and latency
+// percentiles, in the order entries were given to [New].
+func (r *Router) Stats() []ProviderStats {
+	out := make([]ProviderStats, len(r.entries))
+	for i, e := range r.entries {
+		out[i] = r.stats[i].snapshot(e.Name)
+	}
+	return out
+}
+
+// isFailoverable classifies a Complete/Stream error as worth trying the
+// next provider for: transport errors, [StatusError]s with a 5xx or 429
+// code, and context deadlines. Other StatusErrors (4xx other than 429,
+// e.g. bad request or auth) are not failoverable, since they indicate the
+// request itself was rejected rather than a transient problem with this
+// provider, and another provider is likely to reject it the same way.
+// Unclassified errors default to failoverable, since a transient cause
+// can't be ruled out.
+func isFailoverable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.Code >= 500 || status.Code == 429
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return true
+}
@@ -0,0 +1,149 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/duragraph/duragraph-go/llm"
+)
+
+func TestIsFailoverable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"status 400", &StatusError{Code: 400}, false},
+		{"status 401", &StatusError{Code: 401}, false},
+		{"status 429", &StatusError{Code: 429}, true},
+		{"status 503", &StatusError{Code: 503}, true},
+		{"net error", &net.DNSError{IsTimeout: true}, true},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"unclassified", errors.New("boom"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isFailoverable(tt.err); got != tt.want {
+				t.Errorf("isFailoverable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCooldownForGrowsAndCaps(t *testing.T) {
+	prev := time.Duration(0)
+	for streak := 1; streak < maxErrorStreak; streak++ {
+		d := cooldownFor(streak)
+		if d < prev {
+			t.Fatalf("cooldownFor(%d) = %s, want >= previous %s", streak, d, prev)
+		}
+		if d > maxCooldown {
+			t.Fatalf("cooldownFor(%d) = %s, want <= maxCooldown %s", streak, d, maxCooldown)
+		}
+		prev = d
+	}
+
+	// A streak well past maxErrorStreak must still be capped, not overflow.
+	if d := cooldownFor(maxErrorStreak + 10); d != maxCooldown {
+		t.Fatalf("cooldownFor(%d) = %s, want maxCooldown %s", maxErrorStreak+10, d, maxCooldown)
+	}
+}
+
+func TestHealthMarkUnhealthyThenRecovers(t *testing.T) {
+	h := &health{}
+	if h.unhealthy() {
+		t.Fatal("fresh health should not be unhealthy")
+	}
+
+	h.markUnhealthy()
+	if !h.unhealthy() {
+		t.Fatal("health should be unhealthy right after markUnhealthy")
+	}
+
+	h.unhealthyUntil = time.Now().Add(-time.Second)
+	if h.unhealthy() {
+		t.Fatal("health should report healthy once unhealthyUntil has passed")
+	}
+}
+
+type fakeProvider struct {
+	calls int
+	err   error
+	resp  *llm.Response
+}
+
+func (f *fakeProvider) Complete(ctx context.Context, messages []llm.Message, opts ...llm.Option) (*llm.Response, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.resp, nil
+}
+
+func TestRouterFailsOverToHealthyEntry(t *testing.T) {
+	bad := &fakeProvider{err: &StatusError{Code: 503}}
+	good := &fakeProvider{resp: &llm.Response{Content: "ok"}}
+
+	r := New(
+		Entry{Name: "bad", Provider: bad, Priority: 0},
+		Entry{Name: "good", Provider: good, Priority: 1},
+	)
+
+	resp, err := r.Complete(context.Background(), []llm.Message{{Role: "user", Content: "hi"}})
+	if err != nil {
+		t.Fatalf("Complete: unexpected error: %v", err)
+	}
+	if resp.Content != "ok" {
+		t.Fatalf("Complete: got %q, want %q", resp.Content, "ok")
+	}
+	if bad.calls != 1 || good.calls != 1 {
+		t.Fatalf("expected one call to each entry, got bad=%d good=%d", bad.calls, good.calls)
+	}
+	if !r.health[0].unhealthy() {
+		t.Fatal("bad entry should be marked unhealthy after a failoverable error")
+	}
+}
+
+func TestRouterDoesNotFailOverOnNonFailoverableError(t *testing.T) {
+	bad := &fakeProvider{err: &StatusError{Code: 400}}
+	good := &fakeProvider{resp: &llm.Response{Content: "ok"}}
+
+	r := New(
+		Entry{Name: "bad", Provider: bad, Priority: 0},
+		Entry{Name: "good", Provider: good, Priority: 1},
+	)
+
+	_, err := r.Complete(context.Background(), []llm.Message{{Role: "user", Content: "hi"}})
+	if err == nil {
+		t.Fatal("Complete: expected error, got nil")
+	}
+	if good.calls != 0 {
+		t.Fatalf("expected router to stop at the non-failoverable error, good was called %d times", good.calls)
+	}
+}
+
+func TestRouterSkipsUnhealthyEntry(t *testing.T) {
+	unhealthy := &fakeProvider{resp: &llm.Response{Content: "stale"}}
+	good := &fakeProvider{resp: &llm.Response{Content: "ok"}}
+
+	r := New(
+		Entry{Name: "unhealthy", Provider: unhealthy, Priority: 0},
+		Entry{Name: "good", Provider: good, Priority: 1},
+	)
+	r.health[0].markUnhealthy()
+
+	resp, err := r.Complete(context.Background(), []llm.Message{{Role: "user", Content: "hi"}})
+	if err != nil {
+		t.Fatalf("Complete: unexpected error: %v", err)
+	}
+	if resp.Content != "ok" {
+		t.Fatalf("Complete: got %q, want %q", resp.Content, "ok")
+	}
+	if unhealthy.calls != 0 {
+		t.Fatalf("expected unhealthy entry to be skipped, it was called %d times", unhealthy.calls)
+	}
+}
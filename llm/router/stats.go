@@ -0,0 +1,117 @@
+package router
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxLatencySamples bounds how many recent latencies are kept per provider
+// for percentile calculation.
+const maxLatencySamples = 256
+
+// ProviderStats reports request counts, error rate, and latency percentiles
+// for one provider entry, as returned by [Router.Stats].
+type ProviderStats struct {
+	// Name is the Entry's name.
+	Name string
+
+	// Requests is the total number of calls attempted against this
+	// provider.
+	Requests int64
+
+	// Errors is the number of those calls that failed.
+	Errors int64
+
+	// ErrorRate is Errors / Requests, or 0 if Requests is 0.
+	ErrorRate float64
+
+	// P50, P95, P99 are latency percentiles over the most recent
+	// maxLatencySamples successful calls.
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// providerStats accumulates the raw counters and latency samples behind a
+// ProviderStats snapshot.
+type providerStats struct {
+	requests atomic.Int64
+	errors   atomic.Int64
+
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func (s *providerStats) recordSuccess(d time.Duration) {
+	s.requests.Add(1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.samples) < maxLatencySamples {
+		s.samples = append(s.samples, d)
+	} else {
+		s.samples[s.next] = d
+		s.next = (s.next + 1) % maxLatencySamples
+	}
+}
+
+func (s *providerStats) recordError() {
+	s.requests.Add(1)
+	s.errors.Add(1)
+}
+
+func (s *providerStats) snapshot(name string) ProviderStats {
+	requests := s.requests.Load()
+	errors := s.errors.Load()
+
+	stats := ProviderStats{
+		Name:     name,
+		Requests: requests,
+		Errors:   errors,
+	}
+	if requests > 0 {
+		stats.ErrorRate = float64(errors) / float64(requests)
+	}
+
+	s.mu.Lock()
+	samples := append([]time.Duration(nil), s.samples...)
+	s.mu.Unlock()
+
+	if len(samples) == 0 {
+		return stats
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	stats.P50 = percentile(samples, 0.50)
+	stats.P95 = percentile(samples, 0.95)
+	stats.P99 = percentile(samples, 0.99)
+	return stats
+}
+
+// percentile returns the p-th percentile (0..1) of a sorted duration slice.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(sorted)-1))
+	return sorted[idx]
+}
+
+// meanLatency returns the average of recorded samples, or 0 if there are
+// none. Used by the LeastLatency strategy to rank entries.
+func (s *providerStats) meanLatency() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.samples) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range s.samples {
+		total += d
+	}
+	return total / time.Duration(len(s.samples))
+}
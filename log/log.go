@@ -0,0 +1,218 @@
+// Package log provides the structured logging interface used across
+// DuraGraph's graph, llm, and worker packages.
+//
+// # Basic Usage
+//
+//	logger := log.New(log.WithFormat(log.FormatJSON))
+//
+//	g := graph.New[*ChatState]("my_agent", graph.WithLogger(logger))
+//	w := worker.New(g, worker.WithLogger(logger))
+//
+// Call [Logger.With] to attach fields that should appear on every
+// subsequent call, the same way graph and worker attach graph_id, node, and
+// run_id:
+//
+//	nodeLogger := logger.With("graph_id", "my_agent", "node", "think")
+//	nodeLogger.Info("node started")
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level identifies the severity of a log entry.
+type Level int
+
+const (
+	LevelTrace Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the lowercase name of the level, as written in log output.
+func (l Level) String() string {
+	switch l {
+	case LevelTrace:
+		return "trace"
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Format selects how log entries are rendered.
+type Format string
+
+const (
+	// FormatText renders entries as human-readable lines, the default.
+	FormatText Format = "text"
+
+	// FormatJSON renders entries as one JSON object per line.
+	FormatJSON Format = "json"
+)
+
+// Logger is the structured logging interface threaded through graph, llm,
+// and worker. kv pairs are alternating key/value arguments, e.g.
+// logger.Info("node finished", "node", "think", "duration_ms", 120).
+//
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	Trace(msg string, kv ...any)
+	Debug(msg string, kv ...any)
+	Info(msg string, kv ...any)
+	Warn(msg string, kv ...any)
+	Error(msg string, kv ...any)
+
+	// With returns a Logger that prepends kv to every subsequent call's
+	// fields, without mutating the receiver.
+	With(kv ...any) Logger
+}
+
+// Option configures a [Logger] created with [New].
+type Option func(*config)
+
+type config struct {
+	level  Level
+	format Format
+	writer io.Writer
+}
+
+// WithLevel sets the minimum level that will be written. Default is
+// [LevelInfo].
+func WithLevel(l Level) Option {
+	return func(c *config) {
+		c.level = l
+	}
+}
+
+// WithFormat sets the output format. Default is [FormatText].
+func WithFormat(f Format) Option {
+	return func(c *config) {
+		c.format = f
+	}
+}
+
+// WithWriter sets the destination for log output. Default is os.Stderr.
+func WithWriter(w io.Writer) Option {
+	return func(c *config) {
+		c.writer = w
+	}
+}
+
+// New creates a [Logger] writing to os.Stderr in [FormatText] at
+// [LevelInfo] by default; override with options.
+func New(opts ...Option) Logger {
+	cfg := config{
+		level:  LevelInfo,
+		format: FormatText,
+		writer: os.Stderr,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &logger{config: cfg, mu: &sync.Mutex{}}
+}
+
+type logger struct {
+	config config
+	// mu is shared with every logger derived via With, so writes to the
+	// common writer are serialized.
+	mu     *sync.Mutex
+	fields []any
+}
+
+func (l *logger) With(kv ...any) Logger {
+	return &logger{
+		config: l.config,
+		mu:     l.mu,
+		fields: append(append([]any{}, l.fields...), kv...),
+	}
+}
+
+func (l *logger) Trace(msg string, kv ...any) { l.log(LevelTrace, msg, kv) }
+func (l *logger) Debug(msg string, kv ...any) { l.log(LevelDebug, msg, kv) }
+func (l *logger) Info(msg string, kv ...any)  { l.log(LevelInfo, msg, kv) }
+func (l *logger) Warn(msg string, kv ...any)  { l.log(LevelWarn, msg, kv) }
+func (l *logger) Error(msg string, kv ...any) { l.log(LevelError, msg, kv) }
+
+func (l *logger) log(level Level, msg string, kv []any) {
+	if level < l.config.level {
+		return
+	}
+
+	all := make([]any, 0, len(l.fields)+len(kv))
+	all = append(all, l.fields...)
+	all = append(all, kv...)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.config.format == FormatJSON {
+		writeJSON(l.config.writer, level, msg, all)
+		return
+	}
+	writeText(l.config.writer, level, msg, all)
+}
+
+func writeText(w io.Writer, level Level, msg string, kv []any) {
+	var b strings.Builder
+	b.WriteString(time.Now().Format(time.RFC3339))
+	b.WriteString(" [")
+	b.WriteString(level.String())
+	b.WriteString("] ")
+	b.WriteString(msg)
+	for i := 0; i+1 < len(kv); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", kv[i], kv[i+1])
+	}
+	fmt.Fprintln(w, b.String())
+}
+
+func writeJSON(w io.Writer, level Level, msg string, kv []any) {
+	entry := make(map[string]any, len(kv)/2+3)
+	entry["time"] = time.Now().Format(time.RFC3339)
+	entry["level"] = level.String()
+	entry["msg"] = msg
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			continue
+		}
+		entry[key] = kv[i+1]
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(w, string(data))
+}
+
+// nop is a [Logger] that discards everything. It's the default used by
+// graph and worker when no logger is configured, so callers don't need to
+// nil-check before logging.
+type nop struct{}
+
+// NewNop returns a Logger that discards all output.
+func NewNop() Logger { return nop{} }
+
+func (nop) Trace(string, ...any) {}
+func (nop) Debug(string, ...any) {}
+func (nop) Info(string, ...any)  {}
+func (nop) Warn(string, ...any)  {}
+func (nop) Error(string, ...any) {}
+func (nop) With(...any) Logger   { return nop{} }
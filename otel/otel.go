@@ -0,0 +1,93 @@
+// Package otel bridges DuraGraph graph execution to OpenTelemetry tracing.
+//
+// Wrap a node with [Wrap] to emit a span for every Execute call, tagged with
+// graph.id, graph.node, and (when ctx carries a run ID set with
+// graph.WithRunID) graph.run_id. If
+// the wrapped node exposes model usage via [GenAIAttributer], those
+// attributes are attached too, following the OpenTelemetry gen_ai.*
+// semantic conventions.
+//
+// # Basic Usage
+//
+//	g := graph.New[*ChatState]("chat_agent")
+//	g.AddNode("agent", otel.Wrap("chat_agent", "agent", graph.NewToolNode(provider, registry, ...)))
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/duragraph/duragraph-go/graph"
+)
+
+// tracerName identifies this package's spans in OpenTelemetry.
+const tracerName = "github.com/duragraph/duragraph-go/otel"
+
+// GenAIAttributer is implemented by nodes that can describe their model
+// configuration for tracing, following the OpenTelemetry gen_ai.* semantic
+// conventions (e.g. gen_ai.request.model). [graph.ToolNode] implements this.
+type GenAIAttributer interface {
+	GenAIAttributes() map[string]string
+}
+
+// Wrap returns a [graph.Node] that traces every Execute call as a span
+// named "graph.node "+name, tagged with graphID and name. The returned node
+// implements [graph.Router] if and only if inner does, so router-based
+// branching keeps working through the wrapper.
+//
+// Example:
+//
+//	g.AddNode("think", otel.Wrap("my_agent", "think", &ThinkNode{}))
+func Wrap[S any](graphID, name string, inner graph.Node[S]) graph.Node[S] {
+	t := &tracingNode[S]{graphID: graphID, name: name, inner: inner}
+	if router, ok := inner.(graph.Router[S]); ok {
+		return &tracingRouterNode[S]{tracingNode: t, router: router}
+	}
+	return t
+}
+
+type tracingNode[S any] struct {
+	graphID string
+	name    string
+	inner   graph.Node[S]
+}
+
+func (n *tracingNode[S]) Execute(ctx context.Context, state S) (S, error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "graph.node "+n.name)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("graph.id", n.graphID),
+		attribute.String("graph.node", n.name),
+	)
+	if runID, ok := graph.RunIDFromContext(ctx); ok {
+		span.SetAttributes(attribute.String("graph.run_id", runID))
+	}
+	if attributer, ok := n.inner.(GenAIAttributer); ok {
+		for k, v := range attributer.GenAIAttributes() {
+			span.SetAttributes(attribute.String(k, v))
+		}
+	}
+
+	state, err := n.inner.Execute(ctx, state)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return state, err
+}
+
+// tracingRouterNode is returned by [Wrap] instead of [tracingNode] when the
+// wrapped node also implements [graph.Router], so the wrapper only exposes
+// Route when the original node did.
+type tracingRouterNode[S any] struct {
+	*tracingNode[S]
+	router graph.Router[S]
+}
+
+func (n *tracingRouterNode[S]) Route(ctx context.Context, state S) (string, error) {
+	return n.router.Route(ctx, state)
+}
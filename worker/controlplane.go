@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// pollRuns asks the control plane for up to max runs to execute.
+func (w *Worker[S]) pollRuns(ctx context.Context, max int) ([]Run, error) {
+	url := w.config.controlPlane + "/runs/poll?max=" + strconv.Itoa(max)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("worker: build poll request: %w", err)
+	}
+	if w.config.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+w.config.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, &httpStatusError{statusCode: resp.StatusCode, status: resp.Status}
+	}
+
+	var body struct {
+		Runs []Run `json:"runs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("worker: decode poll response: %w", err)
+	}
+	return body.Runs, nil
+}
+
+// reportFailure tells the control plane that run failed permanently, after
+// retries (if any) were exhausted.
+func (w *Worker[S]) reportFailure(ctx context.Context, run Run, cause error) error {
+	return w.postResult(ctx, run, "failed", cause)
+}
+
+// nack returns run to the control plane's queue so another worker can pick
+// it up, used when the worker is draining runs it hadn't started processing
+// yet at shutdown.
+func (w *Worker[S]) nack(ctx context.Context, run Run) error {
+	url := w.config.controlPlane + "/runs/" + run.ID + "/nack"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return fmt.Errorf("worker: build nack request for run %s: %w", run.ID, err)
+	}
+	if w.config.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+w.config.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return &httpStatusError{statusCode: resp.StatusCode, status: resp.Status}
+	}
+	return nil
+}
+
+func (w *Worker[S]) postResult(ctx context.Context, run Run, status string, cause error) error {
+	body := struct {
+		Status string `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}{Status: status}
+	if cause != nil {
+		body.Error = cause.Error()
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("worker: marshal result for run %s: %w", run.ID, err)
+	}
+
+	url := w.config.controlPlane + "/runs/" + run.ID + "/result"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("worker: build result request for run %s: %w", run.ID, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.config.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+w.config.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return &httpStatusError{statusCode: resp.StatusCode, status: resp.Status}
+	}
+	return nil
+}
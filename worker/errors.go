@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ErrWaiting is returned by a run attempt when the graph interrupted
+// itself (see [graph.Interrupt]) rather than finishing or failing. The
+// worker treats this as neither success nor failure: it doesn't retry,
+// doesn't report a failure, and doesn't re-poll the run, since the control
+// plane already learned it's waiting from the EventWaiting SSE frame and
+// won't hand it out again until a resume RPC arrives.
+var ErrWaiting = errors.New("worker: run is waiting for resume")
+
+// TerminalError wraps an error that should not be retried, such as a
+// validation failure. The worker's retry loop reports it as failed
+// immediately instead of burning retries on it.
+type TerminalError struct {
+	Err error
+}
+
+func (e *TerminalError) Error() string { return e.Err.Error() }
+
+func (e *TerminalError) Unwrap() error { return e.Err }
+
+// Terminal wraps err so [Worker]'s retry loop treats it as non-retryable.
+//
+// Example:
+//
+//	if !isValid(input) {
+//	    return worker.Terminal(fmt.Errorf("invalid input: %w", err))
+//	}
+func Terminal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &TerminalError{Err: err}
+}
+
+// httpStatusError represents a non-2xx response from the control plane.
+type httpStatusError struct {
+	statusCode int
+	status     string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("worker: control plane returned %s", e.status)
+}
+
+// isRetryable classifies an error from a run attempt as retryable (network
+// errors, 5xx/429 responses, context deadlines) or terminal (explicit
+// [TerminalError], 4xx responses). Unclassified errors default to
+// retryable, since a transient cause can't be ruled out.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var terminal *TerminalError
+	if errors.As(err, &terminal) {
+		return false
+	}
+
+	var status *httpStatusError
+	if errors.As(err, &status) {
+		return status.statusCode >= 500 || status.statusCode == 429
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return true
+}
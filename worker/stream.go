@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/duragraph/duragraph-go/graph"
+)
+
+// Run describes a unit of work handed out by the control plane: a run ID
+// plus the serialized initial state to execute the graph with.
+type Run struct {
+	// ID uniquely identifies this run.
+	ID string `json:"id"`
+
+	// Input is the initial state, serialized as JSON, to unmarshal into S
+	// before executing the graph.
+	Input json.RawMessage `json:"input"`
+}
+
+// streamRun executes the graph for run via [graph.Graph.Stream] and relays
+// each [graph.Event] to the control plane as Server-Sent Events, posted to
+// POST /runs/{id}/events on the control plane URL.
+//
+// The HTTP request body is written incrementally as events arrive, so the
+// control plane can render partial progress before the run finishes.
+func (w *Worker[S]) streamRun(ctx context.Context, run Run) error {
+	var state S
+	if len(run.Input) > 0 {
+		if err := json.Unmarshal(run.Input, &state); err != nil {
+			return Terminal(fmt.Errorf("worker: decode run %s input: %w", run.ID, err))
+		}
+	}
+
+	events, err := w.graph.Stream(graph.WithRunID(ctx, run.ID), state)
+	if err != nil {
+		return fmt.Errorf("worker: start stream for run %s: %w", run.ID, err)
+	}
+
+	return w.relayEvents(ctx, run.ID, events)
+}
+
+// Resume reloads the checkpoint for runID, continues graph execution with
+// resumeInput injected into the awaiting node, and relays the outcome to
+// the control plane as a single terminal event: EventDone on success,
+// EventWaiting if the resumed node interrupts again, or EventError on
+// failure (Resume runs via [graph.Graph.Resume], not [graph.Graph.Stream],
+// so per-node progress isn't forwarded).
+//
+// Call this when the control plane's resume RPC for a [ErrWaiting] run
+// arrives. Like [Worker.process], it counts toward [Worker.Stats] for the
+// duration of the resume (Inflight), and on a terminal outcome (Processed
+// or Failed); a re-interrupt counts toward neither, the same as a fresh
+// run that's still waiting.
+func (w *Worker[S]) Resume(ctx context.Context, runID string, resumeInput any) error {
+	w.inflight.Add(1)
+	defer w.inflight.Add(-1)
+
+	logger := w.config.logger.With("run_id", runID)
+
+	events := make(chan graph.Event, 1)
+	go func() {
+		defer close(events)
+		state, err := w.graph.Resume(graph.WithRunID(ctx, runID), runID, resumeInput)
+		switch {
+		case err == nil:
+			w.processed.Add(1)
+			logger.Info("run resumed")
+			events <- graph.Event{Type: graph.EventDone, State: state}
+		case errors.Is(err, graph.ErrInterrupt):
+			reason, _ := graph.InterruptReason(err)
+			payload, _ := graph.InterruptPayload(err)
+			logger.Info("run waiting", "reason", reason)
+			events <- graph.Event{Type: graph.EventWaiting, Content: reason, Payload: payload}
+		default:
+			w.failed.Add(1)
+			logger.Error("resume failed", "error", err)
+			events <- graph.Event{Type: graph.EventError, Error: err.Error()}
+		}
+	}()
+
+	return w.relayEvents(ctx, runID, events)
+}
+
+// relayEvents posts events to the control plane as a single Server-Sent
+// Events request body, written incrementally as events arrive so the
+// control plane can render partial progress before the run finishes.
+//
+// It returns [ErrWaiting] if the last event is [graph.EventWaiting], and an
+// error carrying the message if the last event is [graph.EventError].
+func (w *Worker[S]) relayEvents(ctx context.Context, runID string, events <-chan graph.Event) error {
+	pr, pw := io.Pipe()
+
+	url := w.config.controlPlane + "/runs/" + runID + "/events"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, pr)
+	if err != nil {
+		return fmt.Errorf("worker: build events request for run %s: %w", runID, err)
+	}
+	req.Header.Set("Content-Type", "text/event-stream")
+	if w.config.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+w.config.apiKey)
+	}
+
+	sent := make(chan error, 1)
+	go func() {
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			sent <- err
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			sent <- &httpStatusError{statusCode: resp.StatusCode, status: resp.Status}
+			return
+		}
+		sent <- nil
+	}()
+
+	var last graph.Event
+	for ev := range events {
+		last = ev
+		if err := writeSSEFrame(pw, ev); err != nil {
+			pw.CloseWithError(err)
+			<-sent
+			return err
+		}
+	}
+	pw.Close()
+
+	if err := <-sent; err != nil {
+		return err
+	}
+	switch last.Type {
+	case graph.EventWaiting:
+		return ErrWaiting
+	case graph.EventError:
+		return fmt.Errorf("worker: run %s failed: %s", runID, last.Error)
+	}
+	return nil
+}
+
+// writeSSEFrame writes ev to w as a single Server-Sent Events frame:
+//
+//	event: <type>
+//	data: <json>
+//	<blank line>
+func writeSSEFrame(w io.Writer, ev graph.Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("worker: marshal event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, payload)
+	return err
+}
@@ -42,9 +42,15 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/duragraph/duragraph-go/graph"
+	"github.com/duragraph/duragraph-go/log"
 )
 
 // Option configures a Worker.
@@ -52,10 +58,15 @@ import (
 type Option func(*config)
 
 type config struct {
-	controlPlane string
-	concurrency  int
-	pollInterval time.Duration
-	apiKey       string
+	controlPlane    string
+	concurrency     int
+	pollInterval    time.Duration
+	apiKey          string
+	retryLimit      int
+	backoffMin      time.Duration
+	backoffMax      time.Duration
+	shutdownTimeout time.Duration
+	logger          log.Logger
 }
 
 // WithControlPlane sets the control plane URL.
@@ -107,6 +118,87 @@ func WithAPIKey(key string) Option {
 	}
 }
 
+// WithMaxProcs is an alias for [WithConcurrency], provided for parity with
+// runtimes that size their worker pool off of process/core counts rather
+// than "concurrency".
+//
+// Example:
+//
+//	worker.WithMaxProcs(runtime.GOMAXPROCS(0))
+func WithMaxProcs(n int) Option {
+	return WithConcurrency(n)
+}
+
+// WithRetryLimit sets how many additional attempts a run gets after a
+// retryable failure (network errors, 5xx/429 responses, context deadlines)
+// before it's reported as failed. Terminal errors (see [Terminal]) are
+// reported as failed immediately, without consuming a retry.
+//
+// Default is 0 (no retries).
+//
+// Example:
+//
+//	worker.WithRetryLimit(3)
+func WithRetryLimit(n int) Option {
+	return func(c *config) {
+		c.retryLimit = n
+	}
+}
+
+// WithBackoff sets the exponential backoff bounds between retries. Each
+// retry's delay doubles from min, jittered, capped at max.
+//
+// Default is 100ms to 30s.
+//
+// Example:
+//
+//	worker.WithBackoff(100*time.Millisecond, 30*time.Second)
+func WithBackoff(min, max time.Duration) Option {
+	return func(c *config) {
+		c.backoffMin = min
+		c.backoffMax = max
+	}
+}
+
+// WithShutdownTimeout sets how long [Worker.Stop] waits for in-flight runs
+// to finish before giving up.
+//
+// Default is 30 seconds.
+//
+// Example:
+//
+//	worker.WithShutdownTimeout(time.Minute)
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.shutdownTimeout = d
+	}
+}
+
+// WithLogger sets the [log.Logger] the worker uses to record polling,
+// dispatch, retry, and shutdown events. Default is a no-op logger.
+//
+// Example:
+//
+//	worker.WithLogger(log.New())
+func WithLogger(logger log.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// Stats reports counters for runs a [Worker] has processed.
+type Stats struct {
+	// Inflight is the number of runs currently executing.
+	Inflight int64
+
+	// Processed is the number of runs that completed successfully.
+	Processed int64
+
+	// Failed is the number of runs that were reported failed, either
+	// because they hit a terminal error or exhausted their retries.
+	Failed int64
+}
+
 // Worker executes graphs in response to runs from the control plane.
 //
 // Create a Worker with [New], configure it with options, then call [Worker.Start]
@@ -114,6 +206,15 @@ func WithAPIKey(key string) Option {
 type Worker[S any] struct {
 	graph  *graph.Graph[S]
 	config config
+
+	inflight  atomic.Int64
+	processed atomic.Int64
+	failed    atomic.Int64
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	runs   chan Run
+	wg     sync.WaitGroup
 }
 
 // New creates a new worker for the given graph.
@@ -129,8 +230,13 @@ type Worker[S any] struct {
 //	)
 func New[S any](g *graph.Graph[S], opts ...Option) *Worker[S] {
 	cfg := config{
-		concurrency:  1,
-		pollInterval: time.Second,
+		concurrency:     1,
+		pollInterval:    time.Second,
+		retryLimit:      0,
+		backoffMin:      100 * time.Millisecond,
+		backoffMax:      30 * time.Second,
+		shutdownTimeout: 30 * time.Second,
+		logger:          log.NewNop(),
 	}
 	for _, opt := range opts {
 		opt(&cfg)
@@ -142,6 +248,16 @@ func New[S any](g *graph.Graph[S], opts ...Option) *Worker[S] {
 	}
 }
 
+// Stats returns the current run counters. Safe to call concurrently with
+// [Worker.Start].
+func (w *Worker[S]) Stats() Stats {
+	return Stats{
+		Inflight:  w.inflight.Load(),
+		Processed: w.processed.Load(),
+		Failed:    w.failed.Load(),
+	}
+}
+
 // Start begins polling for work from the control plane.
 //
 // This method blocks until the context is cancelled. Use a cancellable context
@@ -156,20 +272,171 @@ func New[S any](g *graph.Graph[S], opts ...Option) *Worker[S] {
 //	    log.Fatal(err)
 //	}
 func (w *Worker[S]) Start(ctx context.Context) error {
-	// TODO: Implement control plane polling
-	// 1. Poll for available runs
-	// 2. Execute graph with run input
-	// 3. Report results back to control plane
-	// 4. Handle human-in-the-loop interrupts
+	ctx, cancel := context.WithCancel(ctx)
+
+	w.mu.Lock()
+	w.cancel = cancel
+	w.runs = make(chan Run, w.config.concurrency)
+	w.mu.Unlock()
+
+	w.config.logger.Info("worker started", "concurrency", w.config.concurrency, "poll_interval", w.config.pollInterval.String())
+
+	for i := 0; i < w.config.concurrency; i++ {
+		w.wg.Add(1)
+		go w.dispatchLoop(ctx)
+	}
+
+	w.wg.Add(1)
+	go w.pollLoop(ctx)
 
 	<-ctx.Done()
-	return ctx.Err()
+	w.wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Stop gracefully stops the worker.
 //
-// Waits for in-progress runs to complete before returning.
+// It stops polling for new runs, waits for in-progress runs to complete
+// (up to [WithShutdownTimeout]), and NACKs anything still queued so another
+// worker can pick it up.
 func (w *Worker[S]) Stop() error {
-	// TODO: Implement graceful shutdown
-	return nil
+	w.mu.Lock()
+	cancel := w.cancel
+	w.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	w.config.logger.Info("worker stopping", "shutdown_timeout", w.config.shutdownTimeout.String())
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		w.config.logger.Info("worker stopped")
+		return nil
+	case <-time.After(w.config.shutdownTimeout):
+		err := fmt.Errorf("worker: shutdown timed out after %s", w.config.shutdownTimeout)
+		w.config.logger.Error("worker stop timed out", "error", err)
+		return err
+	}
+}
+
+// pollLoop requests up to the worker's free capacity in runs per tick and
+// hands them to the dispatch goroutines. It exits and closes w.runs when
+// ctx is cancelled.
+func (w *Worker[S]) pollLoop(ctx context.Context) {
+	defer w.wg.Done()
+	defer close(w.runs)
+
+	ticker := time.NewTicker(w.config.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			capacity := w.config.concurrency - int(w.inflight.Load())
+			if capacity <= 0 {
+				continue
+			}
+
+			runs, err := w.pollRuns(ctx, capacity)
+			if err != nil {
+				w.config.logger.Warn("poll failed", "error", err)
+				continue
+			}
+			if len(runs) > 0 {
+				w.config.logger.Debug("polled runs", "count", len(runs))
+			}
+
+			for _, run := range runs {
+				select {
+				case w.runs <- run:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// dispatchLoop is one of the worker's fixed pool of goroutines. It pulls
+// runs off w.runs and executes them until the channel is closed.
+func (w *Worker[S]) dispatchLoop(ctx context.Context) {
+	defer w.wg.Done()
+
+	for run := range w.runs {
+		if ctx.Err() != nil {
+			// Shutting down: return this run to the queue instead of
+			// starting it.
+			w.config.logger.Debug("nacking run on shutdown", "run_id", run.ID)
+			w.nack(context.Background(), run)
+			continue
+		}
+		w.process(ctx, run)
+	}
+}
+
+// process executes a single run, retrying retryable failures with
+// exponential backoff up to the worker's retry limit before reporting it
+// failed to the control plane.
+func (w *Worker[S]) process(ctx context.Context, run Run) {
+	w.inflight.Add(1)
+	defer w.inflight.Add(-1)
+
+	logger := w.config.logger.With("run_id", run.ID)
+
+	var lastErr error
+	for attempt := 0; attempt <= w.config.retryLimit; attempt++ {
+		lastErr = w.streamRun(ctx, run)
+		if lastErr == nil {
+			w.processed.Add(1)
+			logger.Info("run processed", "attempt", attempt)
+			return
+		}
+		if errors.Is(lastErr, ErrWaiting) {
+			logger.Info("run waiting", "attempt", attempt)
+			return
+		}
+
+		if !isRetryable(lastErr) || attempt == w.config.retryLimit {
+			break
+		}
+
+		backoff := backoffDuration(attempt, w.config.backoffMin, w.config.backoffMax)
+		logger.Warn("run failed, retrying", "error", lastErr, "attempt", attempt, "backoff", backoff.String())
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			lastErr = ctx.Err()
+			break
+		}
+	}
+
+	w.failed.Add(1)
+	logger.Error("run failed", "error", lastErr)
+	w.reportFailure(context.Background(), run, lastErr)
+}
+
+// backoffDuration returns the delay before the given retry attempt
+// (0-indexed), doubling from min and capped at max, with up to 50% jitter.
+func backoffDuration(attempt int, min, max time.Duration) time.Duration {
+	d := min << attempt
+	if d <= 0 || d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)/2+1))
 }
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"terminal", Terminal(errors.New("bad input")), false},
+		{"status 400", &httpStatusError{statusCode: 400, status: "400 Bad Request"}, false},
+		{"status 429", &httpStatusError{statusCode: 429, status: "429 Too Many Requests"}, true},
+		{"status 503", &httpStatusError{statusCode: 503, status: "503 Service Unavailable"}, true},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"unclassified", errors.New("boom"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffDuration(t *testing.T) {
+	min := 100 * time.Millisecond
+	max := 2 * time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoffDuration(attempt, min, max)
+		if d < 0 || d > max {
+			t.Fatalf("backoffDuration(%d): %s out of bounds [0, %s]", attempt, d, max)
+		}
+	}
+}
+
+func TestBackoffDurationCapsAtMax(t *testing.T) {
+	min := 100 * time.Millisecond
+	max := 500 * time.Millisecond
+
+	// A high attempt count would overflow min<<attempt without the cap.
+	d := backoffDuration(40, min, max)
+	if d > max {
+		t.Fatalf("backoffDuration(40) = %s, want <= %s", d, max)
+	}
+}